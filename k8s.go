@@ -22,10 +22,14 @@ const (
 	//
 	// TODO use clusterIDLabel instead when all cert secrets have it.
 	legacyClusterIDLabel = "clusterID"
-
-	SecretNamespace = "default"
 )
 
+// SecretNamespace is the namespace searched when a Config does not set
+// Namespaces. It is a var, not a const, so a process that only ever deals
+// with a single non-default namespace can override it package-wide instead
+// of passing Namespaces/Namespace everywhere.
+var SecretNamespace = "default"
+
 // Cert is a certificate name.
 type Cert string
 
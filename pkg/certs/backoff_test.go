@@ -0,0 +1,77 @@
+package certs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestSearcherWithTimeouts returns a Searcher over an empty fake
+// clientset, so every watch times out, with watchTimeout and maxWait wired
+// straight to Config.WatchTimeout/Config.MaxWait.
+func newTestSearcherWithTimeouts(t *testing.T, watchTimeout, maxWait time.Duration) *Searcher {
+	t.Helper()
+
+	logger, err := micrologger.New(micrologger.Config{})
+	if err != nil {
+		t.Fatalf("creating logger: %v", err)
+	}
+
+	s, err := NewSearcher(Config{
+		K8sClient:    fake.NewSimpleClientset(),
+		Logger:       logger,
+		WatchTimeout: watchTimeout,
+		MaxWait:      maxWait,
+	})
+	if err != nil {
+		t.Fatalf("creating searcher: %v", err)
+	}
+
+	return s
+}
+
+func TestSearchWatchWithRetryFastFailsWhenMaxWaitIsZero(t *testing.T) {
+	watchTimeout := 20 * time.Millisecond
+	s := newTestSearcherWithTimeouts(t, watchTimeout, 0)
+
+	start := time.Now()
+	_, err := s.searchWatchWithRetry(context.Background(), "giantswarm.io/certificate=worker", s.namespaces)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatalf("searchWatchWithRetry succeeded, want timeout error")
+	}
+	if !IsTimeout(err) {
+		t.Fatalf("err = %v, want a timeoutError", err)
+	}
+	// A single attempt: bounded by watchTimeout, not a multiple of it.
+	if elapsed > 3*watchTimeout {
+		t.Fatalf("elapsed = %s, want close to watchTimeout = %s (no retries)", elapsed, watchTimeout)
+	}
+}
+
+func TestSearchWatchWithRetryRetriesUntilMaxWait(t *testing.T) {
+	watchTimeout := 20 * time.Millisecond
+	maxWait := 150 * time.Millisecond
+	s := newTestSearcherWithTimeouts(t, watchTimeout, maxWait)
+
+	start := time.Now()
+	_, err := s.searchWatchWithRetry(context.Background(), "giantswarm.io/certificate=worker", s.namespaces)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatalf("searchWatchWithRetry succeeded, want an error once MaxWait elapses")
+	}
+	// It must have retried at least once past a single watchTimeout, and
+	// backoff.RetryNotify should stop close to MaxWait rather than running
+	// away.
+	if elapsed < 2*watchTimeout {
+		t.Fatalf("elapsed = %s, want at least %s (evidence of a retry)", elapsed, 2*watchTimeout)
+	}
+	if elapsed > maxWait+5*watchTimeout {
+		t.Fatalf("elapsed = %s, want close to MaxWait = %s", elapsed, maxWait)
+	}
+}
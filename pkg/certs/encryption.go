@@ -0,0 +1,226 @@
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/giantswarm/microerror"
+	"golang.org/x/crypto/pbkdf2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	pbkdf2Iterations = 100000
+	pbkdf2KeyLen     = 32
+	saltLen          = 16
+)
+
+// encryptedPrefix marks ciphertext produced by PassphraseEncrypter, so a
+// Decrypter can tell it apart from unencrypted legacy secret data, which has
+// no prefix and is returned unchanged.
+var encryptedPrefix = []byte("giantswarm-certs-encrypted:v1:")
+
+// Encrypter encrypts plaintext bytes before they are written into a Secret.
+type Encrypter interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+}
+
+// Decrypter decrypts bytes read back from a Secret. It must recognize
+// unencrypted legacy data, i.e. data without its magic prefix, and return it
+// unchanged.
+type Decrypter interface {
+	Decrypt(data []byte) ([]byte, error)
+}
+
+// NoopEncrypter is a passthrough Encrypter/Decrypter, used when at-rest
+// encryption is disabled. It is the default for both Config and Writer.
+type NoopEncrypter struct{}
+
+func (NoopEncrypter) Encrypt(plaintext []byte) ([]byte, error) { return plaintext, nil }
+
+func (NoopEncrypter) Decrypt(data []byte) ([]byte, error) { return data, nil }
+
+// PassphraseEncrypter encrypts and decrypts with AES-GCM using a key derived
+// from a passphrase via PBKDF2, in the style of SwarmKit's
+// PassphraseENVVar/PassphraseENVVarPrev manager key encryption. Setting
+// PreviousPassphrase lets data encrypted under an older passphrase keep
+// decrypting while Passphrase is rotated.
+type PassphraseEncrypter struct {
+	Passphrase         string
+	PreviousPassphrase string
+}
+
+func (p PassphraseEncrypter) Encrypt(plaintext []byte) ([]byte, error) {
+	salt := make([]byte, saltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	gcm, err := passphraseGCM(p.Passphrase, salt)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	ciphertext := gcm.Seal(nil, nonce, plaintext, nil)
+
+	out := make([]byte, 0, len(encryptedPrefix)+len(salt)+len(nonce)+len(ciphertext))
+	out = append(out, encryptedPrefix...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	out = append(out, ciphertext...)
+
+	return out, nil
+}
+
+func (p PassphraseEncrypter) Decrypt(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, encryptedPrefix) {
+		// Unencrypted legacy data, stored before at-rest encryption was
+		// enabled.
+		return data, nil
+	}
+	data = data[len(encryptedPrefix):]
+
+	for _, passphrase := range []string{p.Passphrase, p.PreviousPassphrase} {
+		if passphrase == "" {
+			continue
+		}
+
+		plaintext, err := passphraseDecrypt(passphrase, data)
+		if err == nil {
+			return plaintext, nil
+		}
+	}
+
+	return nil, microerror.Maskf(executionFailedError, "decrypting data: no configured passphrase matched")
+}
+
+func passphraseDecrypt(passphrase string, data []byte) ([]byte, error) {
+	if len(data) < saltLen {
+		return nil, microerror.Maskf(invalidSecretError, "ciphertext shorter than salt")
+	}
+	salt, data := data[:saltLen], data[saltLen:]
+
+	gcm, err := passphraseGCM(passphrase, salt)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, microerror.Maskf(invalidSecretError, "ciphertext shorter than nonce")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return plaintext, nil
+}
+
+func passphraseGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(passphrase), salt, pbkdf2Iterations, pbkdf2KeyLen, sha256.New)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return gcm, nil
+}
+
+// WriterConfig configures a Writer.
+type WriterConfig struct {
+	K8sClient kubernetes.Interface
+
+	// Encrypter encrypts data["key"] before it is written. Defaults to
+	// NoopEncrypter, which writes the key in the clear.
+	Encrypter Encrypter
+	// EncryptCA additionally encrypts data["ca"]. data["key"] is always run
+	// through Encrypter.
+	EncryptCA bool
+}
+
+// Writer creates or updates the cert-operator-style Secret for a (clusterID,
+// Cert) pair, encrypting data["key"] (and optionally data["ca"]) with the
+// configured Encrypter first.
+type Writer struct {
+	k8sClient kubernetes.Interface
+	encrypter Encrypter
+	encryptCA bool
+}
+
+func NewWriter(config WriterConfig) (*Writer, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+
+	if config.Encrypter == nil {
+		config.Encrypter = NoopEncrypter{}
+	}
+
+	w := &Writer{
+		k8sClient: config.K8sClient,
+		encrypter: config.Encrypter,
+		encryptCA: config.EncryptCA,
+	}
+
+	return w, nil
+}
+
+// Write creates or updates the Secret holding tls for clusterID/cert.
+func (w *Writer) Write(ctx context.Context, clusterID string, cert Cert, tls TLS) error {
+	key, err := w.encrypter.Encrypt(tls.Key)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	ca := tls.CA
+	if w.encryptCA {
+		ca, err = w.encrypter.Encrypt(tls.CA)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      K8sSecretName(clusterID, cert),
+			Namespace: SecretNamespace,
+			Labels:    K8sSecretLabels(clusterID, cert),
+		},
+		Data: map[string][]byte{
+			"ca":  ca,
+			"crt": tls.Crt,
+			"key": key,
+		},
+	}
+
+	_, err = w.k8sClient.CoreV1().Secrets(SecretNamespace).Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = w.k8sClient.CoreV1().Secrets(SecretNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package certs
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPassphraseEncrypterRoundTrip(t *testing.T) {
+	e := PassphraseEncrypter{Passphrase: "correct horse battery staple"}
+
+	plaintext := []byte("super secret private key bytes")
+
+	ciphertext, err := e.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if bytes.Equal(ciphertext, plaintext) {
+		t.Fatalf("Encrypt did not transform the plaintext")
+	}
+
+	decrypted, err := e.Decrypt(ciphertext)
+	if err != nil {
+		t.Fatalf("Decrypt: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Fatalf("Decrypt = %q, want %q", decrypted, plaintext)
+	}
+}
+
+func TestPassphraseEncrypterPreviousPassphraseRotation(t *testing.T) {
+	old := PassphraseEncrypter{Passphrase: "old passphrase"}
+	plaintext := []byte("private key bytes")
+
+	ciphertext, err := old.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	rotated := PassphraseEncrypter{Passphrase: "new passphrase", PreviousPassphrase: "old passphrase"}
+
+	decrypted, err := rotated.Decrypt(ciphertext)
+	if err != nil {
+		t.Fatalf("Decrypt with rotated passphrase: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Fatalf("Decrypt = %q, want %q", decrypted, plaintext)
+	}
+}
+
+func TestPassphraseEncrypterWrongPassphraseFails(t *testing.T) {
+	e := PassphraseEncrypter{Passphrase: "correct passphrase"}
+
+	ciphertext, err := e.Encrypt([]byte("private key bytes"))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	wrong := PassphraseEncrypter{Passphrase: "wrong passphrase"}
+
+	if _, err := wrong.Decrypt(ciphertext); err == nil {
+		t.Fatalf("Decrypt with wrong passphrase succeeded, want error")
+	}
+}
+
+func TestPassphraseEncrypterLegacyPassthrough(t *testing.T) {
+	e := PassphraseEncrypter{Passphrase: "some passphrase"}
+
+	legacy := []byte("unencrypted legacy key bytes, no magic prefix")
+
+	decrypted, err := e.Decrypt(legacy)
+	if err != nil {
+		t.Fatalf("Decrypt legacy data: %v", err)
+	}
+	if !bytes.Equal(decrypted, legacy) {
+		t.Fatalf("Decrypt = %q, want unchanged %q", decrypted, legacy)
+	}
+}
+
+func TestNoopEncrypterPassthrough(t *testing.T) {
+	n := NoopEncrypter{}
+
+	data := []byte("anything")
+
+	encrypted, err := n.Encrypt(data)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if !bytes.Equal(encrypted, data) {
+		t.Fatalf("Encrypt = %q, want unchanged %q", encrypted, data)
+	}
+
+	decrypted, err := n.Decrypt(data)
+	if err != nil {
+		t.Fatalf("Decrypt: %v", err)
+	}
+	if !bytes.Equal(decrypted, data) {
+		t.Fatalf("Decrypt = %q, want unchanged %q", decrypted, data)
+	}
+}
@@ -0,0 +1,60 @@
+package certs
+
+import "github.com/giantswarm/microerror"
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var executionFailedError = &microerror.Error{
+	Kind: "executionFailedError",
+}
+
+// IsExecutionFailed asserts executionFailedError.
+func IsExecutionFailed(err error) bool {
+	return microerror.Cause(err) == executionFailedError
+}
+
+var wrongTypeError = &microerror.Error{
+	Kind: "wrongTypeError",
+}
+
+// IsWrongType asserts wrongTypeError.
+func IsWrongType(err error) bool {
+	return microerror.Cause(err) == wrongTypeError
+}
+
+var timeoutError = &microerror.Error{
+	Kind: "timeoutError",
+}
+
+// IsTimeout asserts timeoutError.
+func IsTimeout(err error) bool {
+	return microerror.Cause(err) == timeoutError
+}
+
+var invalidSecretError = &microerror.Error{
+	Kind: "invalidSecretError",
+}
+
+// IsInvalidSecret asserts invalidSecretError.
+func IsInvalidSecret(err error) bool {
+	return microerror.Cause(err) == invalidSecretError
+}
+
+// ambiguousSecretError is returned when a (clusterID, cert) pair matches
+// secrets in more than one configured namespace, so there is no single
+// answer to return.
+var ambiguousSecretError = &microerror.Error{
+	Kind: "ambiguousSecretError",
+}
+
+// IsAmbiguousSecret asserts ambiguousSecretError.
+func IsAmbiguousSecret(err error) bool {
+	return microerror.Cause(err) == ambiguousSecretError
+}
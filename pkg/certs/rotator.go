@@ -0,0 +1,140 @@
+package certs
+
+import (
+	"context"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultResyncPeriod bounds how long Rotator.Watch waits between expiry
+// checks when a certificate's remaining lifetime exceeds RenewBefore.
+const DefaultResyncPeriod = 5 * time.Minute
+
+// RotationFunc is invoked once a watched certificate's remaining lifetime has
+// fallen below RenewBefore. A returned error does not stop Rotator.Watch; it
+// is logged and the certificate is rechecked on the next cycle.
+type RotationFunc func(ctx context.Context, clusterID string, cert Cert, tls TLS) error
+
+type RotatorConfig struct {
+	Searcher *Searcher
+	Logger   micrologger.Logger
+
+	// RenewBefore is how long before NotAfter OnExpiringSoon is invoked.
+	RenewBefore time.Duration
+	// OnExpiringSoon is called once a certificate's remaining lifetime drops
+	// below RenewBefore. If nil, Rotator deletes the secret instead, which
+	// causes cert-operator to regenerate it.
+	OnExpiringSoon RotationFunc
+
+	// ResyncPeriod bounds how long Watch waits between checks. Defaults to
+	// DefaultResyncPeriod.
+	ResyncPeriod time.Duration
+}
+
+// Rotator polls a single certificate's expiry via Searcher and triggers
+// rotation once it falls within RenewBefore of NotAfter.
+type Rotator struct {
+	searcher *Searcher
+	logger   micrologger.Logger
+
+	renewBefore    time.Duration
+	onExpiringSoon RotationFunc
+	resyncPeriod   time.Duration
+}
+
+func NewRotator(config RotatorConfig) (*Rotator, error) {
+	if config.Searcher == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Searcher must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.RenewBefore == 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.RenewBefore must not be empty", config)
+	}
+
+	if config.ResyncPeriod == 0 {
+		config.ResyncPeriod = DefaultResyncPeriod
+	}
+
+	r := &Rotator{
+		searcher: config.Searcher,
+		logger:   config.Logger,
+
+		renewBefore:    config.RenewBefore,
+		onExpiringSoon: config.OnExpiringSoon,
+		resyncPeriod:   config.ResyncPeriod,
+	}
+
+	return r, nil
+}
+
+// Watch blocks, periodically checking clusterID's cert for expiry via
+// Searcher and triggering rotation once it falls within RenewBefore of
+// NotAfter. It returns nil when ctx is cancelled.
+func (r *Rotator) Watch(ctx context.Context, clusterID string, cert Cert) error {
+	for {
+		wait, err := r.checkOnce(ctx, clusterID, cert)
+		if err != nil {
+			r.logger.Errorf(ctx, err, "checking certificate expiry", "cluster", clusterID, "certificate", cert)
+			wait = r.resyncPeriod
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// checkOnce inspects clusterID's cert once, triggering rotation if it is due,
+// and returns how long Watch should wait before checking again.
+func (r *Rotator) checkOnce(ctx context.Context, clusterID string, cert Cert) (time.Duration, error) {
+	tls, err := r.searcher.SearchTLSWithMetadata(ctx, clusterID, cert)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	// Guard against clock skew: a certificate whose NotBefore is still in
+	// the future isn't active yet, so time.Until(NotAfter) can't be trusted.
+	if time.Now().Before(tls.NotBefore) {
+		return r.resyncPeriod, nil
+	}
+
+	remaining := time.Until(tls.NotAfter)
+	if remaining < r.renewBefore {
+		if err := r.rotate(ctx, clusterID, cert, tls); err != nil {
+			return 0, microerror.Mask(err)
+		}
+
+		return r.resyncPeriod, nil
+	}
+
+	wait := remaining - r.renewBefore
+	if wait > r.resyncPeriod {
+		wait = r.resyncPeriod
+	}
+
+	return wait, nil
+}
+
+func (r *Rotator) rotate(ctx context.Context, clusterID string, cert Cert, tls TLS) error {
+	if r.onExpiringSoon != nil {
+		return microerror.Mask(r.onExpiringSoon(ctx, clusterID, cert, tls))
+	}
+
+	name := K8sSecretName(clusterID, cert)
+
+	// Delete from tls.Namespace, not SecretNamespace: SearchTLSWithMetadata
+	// may have found the secret in any of Config.Namespaces.
+	err := r.searcher.k8sClient.CoreV1().Secrets(tls.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
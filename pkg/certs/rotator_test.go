@@ -0,0 +1,183 @@
+package certs
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestCertPEM(t *testing.T, notBefore, notAfter time.Time) []byte {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// newTestSearcher returns a Searcher that finds clusterID/cert via List
+// rather than Watch, by configuring more than one namespace: searchWatch
+// only falls back to the fake clientset's Watch (which doesn't replay
+// objects that existed before the watch started) once a List across all
+// namespaces comes up empty.
+func newTestSearcher(t *testing.T, clusterID string, cert Cert, crt []byte) *Searcher {
+	t.Helper()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      K8sSecretName(clusterID, cert),
+			Namespace: SecretNamespace,
+			Labels:    K8sSecretLabels(clusterID, cert),
+		},
+		Data: map[string][]byte{
+			"ca":  []byte("ca"),
+			"crt": crt,
+			"key": []byte("key"),
+		},
+	}
+
+	logger, err := micrologger.New(micrologger.Config{})
+	if err != nil {
+		t.Fatalf("creating logger: %v", err)
+	}
+
+	s, err := NewSearcher(Config{
+		K8sClient:  fake.NewSimpleClientset(secret),
+		Logger:     logger,
+		Namespaces: []string{SecretNamespace, "other-namespace"},
+	})
+	if err != nil {
+		t.Fatalf("creating searcher: %v", err)
+	}
+
+	return s
+}
+
+func TestRotatorCheckOnce(t *testing.T) {
+	const clusterID = "test-cluster"
+	const cert = WorkerCert
+
+	testCases := []struct {
+		name         string
+		notBefore    time.Time
+		notAfter     time.Time
+		renewBefore  time.Duration
+		resyncPeriod time.Duration
+		wantRotated  bool
+		wantMaxWait  time.Duration
+	}{
+		{
+			name:         "not yet valid cert is left alone despite being within renewBefore of NotAfter",
+			notBefore:    time.Now().Add(time.Hour),
+			notAfter:     time.Now().Add(90 * time.Minute),
+			renewBefore:  2 * time.Hour,
+			resyncPeriod: 5 * time.Minute,
+			wantRotated:  false,
+			wantMaxWait:  5 * time.Minute,
+		},
+		{
+			name:         "remaining lifetime under renewBefore triggers rotation",
+			notBefore:    time.Now().Add(-time.Hour),
+			notAfter:     time.Now().Add(30 * time.Minute),
+			renewBefore:  time.Hour,
+			resyncPeriod: 5 * time.Minute,
+			wantRotated:  true,
+		},
+		{
+			name:         "remaining lifetime well above renewBefore waits, capped at resyncPeriod",
+			notBefore:    time.Now().Add(-time.Hour),
+			notAfter:     time.Now().Add(24 * time.Hour),
+			renewBefore:  time.Hour,
+			resyncPeriod: 5 * time.Minute,
+			wantRotated:  false,
+			wantMaxWait:  5 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			crt := newTestCertPEM(t, tc.notBefore, tc.notAfter)
+			searcher := newTestSearcher(t, clusterID, cert, crt)
+
+			var rotated bool
+			r, err := NewRotator(RotatorConfig{
+				Searcher:     searcher,
+				Logger:       searcher.logger,
+				RenewBefore:  tc.renewBefore,
+				ResyncPeriod: tc.resyncPeriod,
+				OnExpiringSoon: func(ctx context.Context, clusterID string, cert Cert, tls TLS) error {
+					rotated = true
+					return nil
+				},
+			})
+			if err != nil {
+				t.Fatalf("creating rotator: %v", err)
+			}
+
+			wait, err := r.checkOnce(context.Background(), clusterID, cert)
+			if err != nil {
+				t.Fatalf("checkOnce: %v", err)
+			}
+
+			if rotated != tc.wantRotated {
+				t.Fatalf("rotated = %v, want %v", rotated, tc.wantRotated)
+			}
+			if !tc.wantRotated && wait > tc.wantMaxWait {
+				t.Fatalf("wait = %s, want at most %s", wait, tc.wantMaxWait)
+			}
+		})
+	}
+}
+
+func TestRotatorDeletesFromSecretsActualNamespace(t *testing.T) {
+	const clusterID = "test-cluster"
+	const cert = WorkerCert
+
+	crt := newTestCertPEM(t, time.Now().Add(-time.Hour), time.Now().Add(time.Minute))
+	searcher := newTestSearcher(t, clusterID, cert, crt)
+
+	r, err := NewRotator(RotatorConfig{
+		Searcher:    searcher,
+		Logger:      searcher.logger,
+		RenewBefore: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("creating rotator: %v", err)
+	}
+
+	if _, err := r.checkOnce(context.Background(), clusterID, cert); err != nil {
+		t.Fatalf("checkOnce: %v", err)
+	}
+
+	name := K8sSecretName(clusterID, cert)
+	if _, err := searcher.k8sClient.CoreV1().Secrets(SecretNamespace).Get(context.Background(), name, metav1.GetOptions{}); err == nil {
+		t.Fatalf("secret %s/%s still exists, want it deleted", SecretNamespace, name)
+	}
+}
@@ -2,18 +2,26 @@ package certs
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	listercorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -26,14 +34,81 @@ type Config struct {
 	K8sClient kubernetes.Interface
 	Logger    micrologger.Logger
 
+	// Namespaces restricts searches to the given namespaces. If empty, only
+	// SecretNamespace is searched. A (clusterID, cert) pair found in more
+	// than one of these namespaces is reported as an error rather than
+	// silently returning one of them.
+	Namespaces []string
+
+	// Decrypter decrypts data["key"] and data["ca"] once read from a Secret.
+	// Defaults to NoopEncrypter, which returns data unchanged. Pair with a
+	// Writer using the matching Encrypter to write secrets encrypted at rest.
+	Decrypter Decrypter
+
 	WatchTimeout time.Duration
+
+	// MaxWait bounds how long the watch fallback retries, with exponential
+	// backoff, after a failed or timed-out watch before giving up. Zero (the
+	// default) preserves the original fast-fail behavior: a single attempt
+	// bounded by WatchTimeout.
+	MaxWait time.Duration
+}
+
+// SearchOption customizes a single Search* call. See WithNamespace.
+type SearchOption func(*searchOptions)
+
+type searchOptions struct {
+	namespaces []string
+	certs      []Cert
+}
+
+// WithNamespace restricts a single search call to namespace, overriding
+// Config.Namespaces for that call only.
+func WithNamespace(namespace string) SearchOption {
+	return func(o *searchOptions) {
+		o.namespaces = []string{namespace}
+	}
+}
+
+// WithCerts restricts SearchAll's missing-certificate check to certs,
+// instead of every Cert the cluster's secrets happen to carry. Pass the
+// set a given provider/role is expected to hold, e.g. the certs a
+// particular operator issues, so SearchAll can tell a genuinely missing
+// cert apart from one that never applies to this cluster.
+func WithCerts(certs ...Cert) SearchOption {
+	return func(o *searchOptions) {
+		o.certs = certs
+	}
 }
 
 type Searcher struct {
 	k8sClient kubernetes.Interface
 	logger    micrologger.Logger
 
+	namespaces   []string
 	watchTimeout time.Duration
+	maxWait      time.Duration
+	decrypter    Decrypter
+
+	// secretLister and secretSynced serve lookups from the shared informer
+	// cache started by NewSearcherWithInformer. They are nil for a Searcher
+	// created with NewSearcher, in which case search falls back to watching
+	// the API server directly on every call.
+	secretLister listercorev1.SecretLister
+	secretSynced cache.InformerSynced
+
+	// stopInformer, when non-nil, stops the informer started by
+	// NewSearcherWithInformer. It is independent of the context passed to
+	// NewSearcherWithInformer, which only bounds the initial cache sync: the
+	// informer itself keeps running until Stop is called.
+	stopInformer chan struct{}
+	stopOnce     sync.Once
+
+	// stopped is set by Stop. secretSynced stays true forever once an
+	// informer has synced even after its stop channel is closed, so search
+	// checks stopped rather than secretSynced alone to know whether the
+	// cache is still being kept up to date.
+	stopped atomic.Bool
 }
 
 func NewSearcher(config Config) (*Searcher, error) {
@@ -48,16 +123,95 @@ func NewSearcher(config Config) (*Searcher, error) {
 		config.WatchTimeout = DefaultWatchTimeout
 	}
 
+	namespaces := config.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{SecretNamespace}
+	}
+
+	if config.Decrypter == nil {
+		config.Decrypter = NoopEncrypter{}
+	}
+
 	s := &Searcher{
 		k8sClient: config.K8sClient,
 		logger:    config.Logger,
 
+		namespaces:   namespaces,
 		watchTimeout: config.WatchTimeout,
+		maxWait:      config.MaxWait,
+		decrypter:    config.Decrypter,
+	}
+
+	return s, nil
+}
+
+// NewSearcherWithInformer returns a Searcher backed by a shared secret
+// informer, so repeated searches for the same cluster read from an
+// in-process cache instead of opening a fresh watch against the API server
+// every time. The informer is filtered to secrets carrying both
+// certificateLabel and clusterLabel. ctx only bounds how long
+// NewSearcherWithInformer waits for the initial cache sync: once it returns,
+// the informer keeps running independently of ctx (e.g. even if ctx carries
+// a deadline and that deadline passes) until the returned Searcher's Stop
+// method is called. Call Stop when the Searcher is no longer needed, or the
+// informer's goroutine and watch connection leak for the life of the
+// process.
+//
+// Until the cache has synced, search falls back to the same per-call watch
+// NewSearcher uses, so callers can safely use the returned Searcher right
+// away.
+func NewSearcherWithInformer(ctx context.Context, config Config) (*Searcher, error) {
+	s, err := NewSearcher(config)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	// A single configured namespace can be watched directly; watching more
+	// than one requires listening cluster-wide and filtering by namespace in
+	// searchCache, since SharedInformerFactory only scopes to one namespace.
+	namespace := metav1.NamespaceAll
+	if len(s.namespaces) == 1 {
+		namespace = s.namespaces[0]
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		config.K8sClient,
+		0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(o *metav1.ListOptions) {
+			o.LabelSelector = fmt.Sprintf("%s,%s", certificateLabel, clusterLabel)
+		}),
+	)
+
+	secretInformer := factory.Core().V1().Secrets()
+	s.secretLister = secretInformer.Lister()
+	s.secretSynced = secretInformer.Informer().HasSynced
+
+	s.stopInformer = make(chan struct{})
+	factory.Start(s.stopInformer)
+
+	if !cache.WaitForCacheSync(ctx.Done(), s.secretSynced) {
+		close(s.stopInformer)
+		return nil, microerror.Maskf(executionFailedError, "waiting for secret informer cache to sync")
 	}
 
 	return s, nil
 }
 
+// Stop stops the informer started by NewSearcherWithInformer. It is a no-op
+// for a Searcher created with NewSearcher. Searches after Stop fall back to
+// the per-call watch: secretSynced would otherwise keep reporting true
+// forever, so Stop marks the Searcher directly rather than relying on it.
+// Stop is safe to call more than once.
+func (s *Searcher) Stop() {
+	s.stopOnce.Do(func() {
+		s.stopped.Store(true)
+		if s.stopInformer != nil {
+			close(s.stopInformer)
+		}
+	})
+}
+
 func (s *Searcher) SearchAppOperator(ctx context.Context, clusterID string) (AppOperator, error) {
 	var appOperator AppOperator
 
@@ -82,7 +236,7 @@ func (s *Searcher) SearchAppOperator(ctx context.Context, clusterID string) (App
 
 			m.Lock()
 			defer m.Unlock()
-			err = fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
+			err = s.fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -123,7 +277,7 @@ func (s *Searcher) SearchClusterOperator(ctx context.Context, clusterID string)
 
 			m.Lock()
 			defer m.Unlock()
-			err = fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
+			err = s.fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -164,7 +318,7 @@ func (s *Searcher) SearchDraining(ctx context.Context, clusterID string) (Draini
 
 			m.Lock()
 			defer m.Unlock()
-			err = fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
+			err = s.fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -205,7 +359,7 @@ func (s *Searcher) SearchMonitoring(ctx context.Context, clusterID string) (Moni
 
 			m.Lock()
 			defer m.Unlock()
-			err = fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
+			err = s.fillTLSFromSecret(c.TLS, secret, clusterID, c.Cert)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -222,15 +376,15 @@ func (s *Searcher) SearchMonitoring(ctx context.Context, clusterID string) (Moni
 	return monitoring, nil
 }
 
-func (s *Searcher) SearchTLS(ctx context.Context, clusterID string, cert Cert) (TLS, error) {
+func (s *Searcher) SearchTLS(ctx context.Context, clusterID string, cert Cert, opts ...SearchOption) (TLS, error) {
 	tls := &TLS{}
 
-	secret, err := s.search(ctx, tls, clusterID, cert)
+	secret, err := s.search(ctx, tls, clusterID, cert, opts...)
 	if err != nil {
 		return TLS{}, microerror.Mask(err)
 	}
 
-	err = fillTLSFromSecret(tls, secret, clusterID, cert)
+	err = s.fillTLSFromSecret(tls, secret, clusterID, cert)
 	if err != nil {
 		return TLS{}, microerror.Mask(err)
 	}
@@ -238,14 +392,359 @@ func (s *Searcher) SearchTLS(ctx context.Context, clusterID string, cert Cert) (
 	return *tls, nil
 }
 
-func (s *Searcher) search(ctx context.Context, tls *TLS, clusterID string, cert Cert) (*corev1.Secret, error) {
+// SearchTLSWithMetadata behaves like SearchTLS, additionally parsing Crt's
+// PEM block into NotBefore, NotAfter, Issuer, SerialNumber, and DNSNames.
+func (s *Searcher) SearchTLSWithMetadata(ctx context.Context, clusterID string, cert Cert, opts ...SearchOption) (TLS, error) {
+	tls, err := s.SearchTLS(ctx, clusterID, cert, opts...)
+	if err != nil {
+		return TLS{}, microerror.Mask(err)
+	}
+
+	err = fillTLSMetadata(&tls)
+	if err != nil {
+		return TLS{}, microerror.Mask(err)
+	}
+
+	return tls, nil
+}
+
+func fillTLSMetadata(tls *TLS) error {
+	block, _ := pem.Decode(tls.Crt)
+	if block == nil {
+		return microerror.Maskf(invalidSecretError, "%q does not contain a PEM block", "crt")
+	}
+
+	x509Cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	tls.NotBefore = x509Cert.NotBefore
+	tls.NotAfter = x509Cert.NotAfter
+	tls.Issuer = x509Cert.Issuer.String()
+	tls.SerialNumber = x509Cert.SerialNumber.String()
+	tls.DNSNames = x509Cert.DNSNames
+
+	return nil
+}
+
+// SearchAll returns every certificate cert-operator has issued for
+// clusterID, fetched with a single List against the clusterLabel selector
+// instead of one watch per certificate. By default it returns whatever
+// subset of AllCerts the cluster's secrets carry with a nil error: most
+// clusters never hold all of AllCerts (several are provider- or
+// role-specific), so treating the full set as required would fail nearly
+// every call. Pass WithCerts to check a specific, known-expected subset
+// instead; only then does a missing certificate produce a non-nil error,
+// alongside the partial map, naming what wasn't found.
+func (s *Searcher) SearchAll(ctx context.Context, clusterID string, opts ...SearchOption) (map[Cert]TLS, error) {
+	o := searchOptions{namespaces: s.namespaces}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	selector := fmt.Sprintf("%s=%s", clusterLabel, clusterID)
+
+	secretsByCert, err := s.listSecretsByCert(ctx, selector, o.namespaces)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result := make(map[Cert]TLS, len(secretsByCert))
+	for cert, secret := range secretsByCert {
+		tls := TLS{}
+		err := s.fillTLSFromSecret(&tls, secret, clusterID, cert)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		result[cert] = tls
+	}
+
+	if o.certs != nil {
+		var missing []Cert
+		for _, cert := range o.certs {
+			if _, ok := result[cert]; !ok {
+				missing = append(missing, cert)
+			}
+		}
+
+		if len(missing) > 0 {
+			return result, microerror.Maskf(executionFailedError, "cluster = %q: missing certificates %v", clusterID, missing)
+		}
+	}
+
+	return result, nil
+}
+
+// SearchAllClusters returns cert for every cluster currently holding a
+// matching secret, fetched with a single List against the certificateLabel
+// selector instead of one watch per cluster.
+func (s *Searcher) SearchAllClusters(ctx context.Context, cert Cert, opts ...SearchOption) (map[string]TLS, error) {
+	o := searchOptions{namespaces: s.namespaces}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	selector := fmt.Sprintf("%s=%s", certificateLabel, cert)
+
+	secrets, err := s.listSecrets(ctx, selector, o.namespaces)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result := make(map[string]TLS, len(secrets))
+	foundIn := make(map[string]string, len(secrets))
+
+	for _, secret := range secrets {
+		clusterID := secret.Labels[clusterLabel]
+
+		if namespace, ok := foundIn[clusterID]; ok {
+			return nil, microerror.Maskf(ambiguousSecretError, "cluster = %q, certificate = %q found in both namespace %q and %q", clusterID, cert, namespace, secret.Namespace)
+		}
+		foundIn[clusterID] = secret.Namespace
+
+		tls := TLS{}
+		err := s.fillTLSFromSecret(&tls, secret, clusterID, cert)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		result[clusterID] = tls
+	}
+
+	return result, nil
+}
+
+// listSecretsByCert lists secrets matching selector across namespaces and
+// indexes them by certificateLabel, for use by SearchAll. The same
+// certificate found in more than one namespace is an error rather than a
+// silent overwrite of one match by the other.
+func (s *Searcher) listSecretsByCert(ctx context.Context, selector string, namespaces []string) (map[Cert]*corev1.Secret, error) {
+	secrets, err := s.listSecrets(ctx, selector, namespaces)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result := make(map[Cert]*corev1.Secret, len(secrets))
+	for _, secret := range secrets {
+		cert := Cert(secret.Labels[certificateLabel])
+
+		if existing, ok := result[cert]; ok {
+			return nil, microerror.Maskf(ambiguousSecretError, "certificate = %q found in both namespace %q and %q", cert, existing.Namespace, secret.Namespace)
+		}
+		result[cert] = secret
+	}
+
+	return result, nil
+}
+
+// listSecrets lists secrets matching selector in each of namespaces with a
+// single List call per namespace.
+func (s *Searcher) listSecrets(ctx context.Context, selector string, namespaces []string) ([]*corev1.Secret, error) {
+	o := metav1.ListOptions{
+		LabelSelector: selector,
+	}
+
+	var secrets []*corev1.Secret
+
+	for _, namespace := range namespaces {
+		list, err := s.k8sClient.CoreV1().Secrets(namespace).List(ctx, o)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		for i := range list.Items {
+			secrets = append(secrets, &list.Items[i])
+		}
+	}
+
+	return secrets, nil
+}
+
+func (s *Searcher) search(ctx context.Context, tls *TLS, clusterID string, cert Cert, opts ...SearchOption) (*corev1.Secret, error) {
+	o := searchOptions{namespaces: s.namespaces}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	selector := fmt.Sprintf("%s=%s, %s=%s", certificateLabel, cert, clusterLabel, clusterID)
+
+	if s.secretSynced != nil && !s.stopped.Load() && s.secretSynced() {
+		secret, err := s.searchCache(selector, o.namespaces)
+		if err == nil {
+			return secret, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, microerror.Mask(err)
+		}
+
+		// Fall through to the watch below: the cache hasn't observed this
+		// secret yet, e.g. because it was only just created.
+	}
+
+	return s.searchWatchWithRetry(ctx, selector, o.namespaces)
+}
+
+// searchCache serves a lookup from the informer-backed lister started by
+// NewSearcherWithInformer, restricted to namespaces. Finding the selector
+// satisfied in more than one of namespaces is an error: the caller asked for
+// one certificate, and serving an arbitrary pick among several would be a
+// silent correctness hazard.
+func (s *Searcher) searchCache(selector string, namespaces []string) (*corev1.Secret, error) {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	secrets, err := s.secretLister.List(sel)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	matches := filterByNamespace(secrets, namespaces)
+	if len(matches) == 0 {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), selector)
+	}
+	if len(matches) > 1 {
+		return nil, microerror.Maskf(ambiguousSecretError, "selector = %q matched secrets in namespaces %v", selector, secretNamespaces(matches))
+	}
+
+	return matches[0], nil
+}
+
+func filterByNamespace(secrets []*corev1.Secret, namespaces []string) []*corev1.Secret {
+	var matches []*corev1.Secret
+
+	for _, secret := range secrets {
+		for _, namespace := range namespaces {
+			if secret.Namespace == namespace {
+				matches = append(matches, secret)
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+func secretNamespaces(secrets []*corev1.Secret) []string {
+	namespaces := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		namespaces = append(namespaces, secret.Namespace)
+	}
+
+	return namespaces
+}
+
+// searchWatchWithRetry wraps searchWatch in exponential backoff bounded by
+// MaxWait, so a caller can say "wait up to 2 minutes for this cert to
+// appear" instead of failing the first time the API server is briefly
+// unreachable or cert-operator hasn't created the secret yet. When MaxWait
+// is zero it calls searchWatch directly, preserving the original fast-fail
+// behavior bounded by WatchTimeout.
+func (s *Searcher) searchWatchWithRetry(ctx context.Context, selector string, namespaces []string) (*corev1.Secret, error) {
+	if s.maxWait == 0 {
+		return s.searchWatch(ctx, selector, namespaces)
+	}
+
+	var secret *corev1.Secret
+
+	operation := func() error {
+		var err error
+		secret, err = s.searchWatch(ctx, selector, namespaces)
+		return err
+	}
+
+	notify := func(err error, wait time.Duration) {
+		s.logger.Debugf(ctx, "retrying secret search in %s, selector = %q: %s", wait, selector, err)
+	}
+
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = s.maxWait
+	// The library default InitialInterval (500ms) can exceed MaxWait on its
+	// own, which makes NextBackOff return Stop after the very first failed
+	// attempt and never actually retry. Scale it to WatchTimeout instead. A
+	// retry still only happens when the time the first attempt took plus the
+	// first wait (randomized around WatchTimeout/2) fit within MaxWait.
+	b.InitialInterval = s.watchTimeout / 2
+
+	err := backoff.RetryNotify(operation, backoff.WithContext(b, ctx), notify)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return secret, nil
+}
+
+// searchWatch is the original, per-call watch against the API server. It is
+// kept as a fallback for Searcher instances without a synced informer cache.
+//
+// When more than one namespace is configured, it first does a single List
+// per namespace to check whether the secret already exists anywhere, the
+// same way searchCache does, so the pair-found-in-more-than-one-namespace
+// guarantee holds here too. If the secret doesn't exist yet in any of them,
+// it falls through to watching each namespace in turn, first match wins:
+// disambiguating a race between two namespaces that both get the secret
+// created concurrently isn't something a per-call watch can do any better
+// than returning whichever arrives first.
+func (s *Searcher) searchWatch(ctx context.Context, selector string, namespaces []string) (*corev1.Secret, error) {
+	if len(namespaces) > 1 {
+		secret, err := s.searchList(ctx, selector, namespaces)
+		if err == nil {
+			return secret, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, microerror.Mask(err)
+		}
+
+		// Fall through: none of the namespaces has the secret yet.
+	}
+
+	for i, namespace := range namespaces {
+		secret, err := s.searchWatchNamespace(ctx, selector, namespace)
+		if apierrors.IsTimeout(err) || microerror.Cause(err) == timeoutError {
+			if i == len(namespaces)-1 {
+				return nil, microerror.Mask(err)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return secret, nil
+	}
+
+	return nil, microerror.Maskf(timeoutError, "waiting secrets, selector = %q", selector)
+}
+
+// searchList looks up selector across namespaces with a single List per
+// namespace, giving the watch fallback the same disambiguation guarantee
+// searchCache gives the informer-backed path.
+func (s *Searcher) searchList(ctx context.Context, selector string, namespaces []string) (*corev1.Secret, error) {
+	secrets, err := s.listSecrets(ctx, selector, namespaces)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	if len(secrets) == 0 {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), selector)
+	}
+	if len(secrets) > 1 {
+		return nil, microerror.Maskf(ambiguousSecretError, "selector = %q matched secrets in namespaces %v", selector, secretNamespaces(secrets))
+	}
+
+	return secrets[0], nil
+}
+
+func (s *Searcher) searchWatchNamespace(ctx context.Context, selector string, namespace string) (*corev1.Secret, error) {
 	// Select only secrets that match the given certificate and the given cluster
 	// ID.
 	o := metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("%s=%s, %s=%s", certificateLabel, cert, clusterLabel, clusterID),
+		LabelSelector: selector,
 	}
 
-	watcher, err := s.k8sClient.CoreV1().Secrets(SecretNamespace).Watch(ctx, o)
+	watcher, err := s.k8sClient.CoreV1().Secrets(namespace).Watch(ctx, o)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
@@ -279,7 +778,7 @@ func (s *Searcher) search(ctx context.Context, tls *TLS, clusterID string, cert
 	}
 }
 
-func fillTLSFromSecret(tls *TLS, secret *corev1.Secret, cluster string, cert Cert) error {
+func (s *Searcher) fillTLSFromSecret(tls *TLS, secret *corev1.Secret, cluster string, cert Cert) error {
 	{
 		var l string
 
@@ -293,6 +792,8 @@ func fillTLSFromSecret(tls *TLS, secret *corev1.Secret, cluster string, cert Cer
 		}
 	}
 
+	tls.Namespace = secret.Namespace
+
 	{
 		var ok bool
 
@@ -307,5 +808,19 @@ func fillTLSFromSecret(tls *TLS, secret *corev1.Secret, cluster string, cert Cer
 		}
 	}
 
+	{
+		var err error
+
+		// CA is only ever encrypted if a Writer was configured to do so;
+		// Decrypt recognizes plaintext legacy data by its missing magic
+		// prefix and returns it unchanged either way.
+		if tls.CA, err = s.decrypter.Decrypt(tls.CA); err != nil {
+			return microerror.Mask(err)
+		}
+		if tls.Key, err = s.decrypter.Decrypt(tls.Key); err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,29 @@
+package certs
+
+import "time"
+
+// TLS holds the certificate, private key, and CA bundle cert-operator writes
+// for a single (clusterID, Cert) pair, plus metadata parsed from Crt once a
+// caller asks for it via SearchTLSWithMetadata.
+type TLS struct {
+	CA  []byte
+	Crt []byte
+	Key []byte
+
+	// Namespace is the namespace of the Secret this TLS was read from. It is
+	// set by Searcher and is what a caller should target when acting back on
+	// the underlying Secret, e.g. deleting it to trigger regeneration.
+	Namespace string
+
+	// NotBefore is Crt's validity start, as parsed from its PEM block.
+	NotBefore time.Time
+	// NotAfter is Crt's validity end. Once it has passed, the certificate is
+	// expired.
+	NotAfter time.Time
+	// Issuer is the distinguished name of Crt's issuer.
+	Issuer string
+	// SerialNumber is Crt's serial number, formatted as a decimal string.
+	SerialNumber string
+	// DNSNames lists the Subject Alternative Names Crt was issued for.
+	DNSNames []string
+}